@@ -0,0 +1,104 @@
+package sendinblue
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// DefaultMaxAttachmentSize is the maximum number of source bytes AttachmentFromFile and AttachmentFromReader will
+// encode unless overridden with WithMaxAttachmentSize.
+const DefaultMaxAttachmentSize int64 = 10 << 20 // 10 MiB
+
+// attachmentConfig holds the options accumulated by AttachmentOption functions
+type attachmentConfig struct {
+	maxSize     int64
+	contentID   string
+	disposition string
+}
+
+// AttachmentOption configures optional parameters of an Attachment built by AttachmentFromFile or AttachmentFromReader.
+type AttachmentOption func(*attachmentConfig)
+
+// WithMaxAttachmentSize overrides DefaultMaxAttachmentSize for a single attachment build.
+func WithMaxAttachmentSize(n int64) AttachmentOption {
+	return func(c *attachmentConfig) {
+		c.maxSize = n
+	}
+}
+
+// WithContentID marks the built Attachment as inline content referenceable from HTML bodies via "cid:<id>".
+func WithContentID(id string) AttachmentOption {
+	return func(c *attachmentConfig) {
+		c.contentID = id
+		if c.disposition == "" {
+			c.disposition = "inline"
+		}
+	}
+}
+
+// WithDisposition overrides the MIME Content-Disposition ("attachment" or "inline") used by Message.WriteMultipart.
+func WithDisposition(d string) AttachmentOption {
+	return func(c *attachmentConfig) {
+		c.disposition = d
+	}
+}
+
+// AttachmentFromFile builds an Attachment from the file at path, streaming its contents through the Base64 encoder
+// rather than buffering the raw file in memory.  The Content-Type is auto-detected from the first 512 bytes of the
+// file via http.DetectContentType.
+func AttachmentFromFile(path string, opts ...AttachmentOption) (*Attachment, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open attachment file: %w", err)
+	}
+	defer f.Close()
+
+	return AttachmentFromReader(filepath.Base(path), f, opts...)
+}
+
+// AttachmentFromReader builds an Attachment named name from r, streaming its contents through the Base64 encoder
+// into a bounded buffer rather than reading the whole source into memory first.  The Content-Type is auto-detected
+// from the first 512 bytes read via http.DetectContentType.  The source is rejected once it exceeds
+// DefaultMaxAttachmentSize, or the size set via WithMaxAttachmentSize.
+func AttachmentFromReader(name string, r io.Reader, opts ...AttachmentOption) (*Attachment, error) {
+	cfg := &attachmentConfig{maxSize: DefaultMaxAttachmentSize}
+	for _, o := range opts {
+		o(cfg)
+	}
+
+	var sniff [512]byte
+	n, err := io.ReadFull(r, sniff[:])
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, fmt.Errorf("failed to read attachment content: %w", err)
+	}
+	contentType := http.DetectContentType(sniff[:n])
+
+	src := io.MultiReader(bytes.NewReader(sniff[:n]), r)
+
+	var buf bytes.Buffer
+	enc := base64.NewEncoder(base64.StdEncoding, &buf)
+
+	written, err := io.Copy(enc, io.LimitReader(src, cfg.maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode attachment content: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode attachment content: %w", err)
+	}
+	if written > cfg.maxSize {
+		return nil, fmt.Errorf("attachment %q exceeds maximum size of %d bytes", name, cfg.maxSize)
+	}
+
+	return &Attachment{
+		Name:        name,
+		Content:     buf.String(),
+		ContentType: contentType,
+		ContentID:   cfg.contentID,
+		Disposition: cfg.disposition,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package sendinblue
+
+import "context"
+
+// MaxRecipientsPerRequest is SendInBlue's documented cap on the number of Versions entries accepted by a single
+// POST to /smtp/email.
+const MaxRecipientsPerRequest = 1000
+
+// BatchSendResult aggregates the results of one or more /smtp/email calls issued by Client.SendTransactionalEmailBatch.
+type BatchSendResult struct {
+
+	// MessageIDs holds the message identifiers returned by every batch which sent successfully
+	MessageIDs []string
+
+	// Errors holds one error per batch which failed to send.  A failed batch does not prevent later batches from
+	// being attempted.
+	Errors []error
+}
+
+// SendTransactionalEmailBatch sends m, automatically splitting m.Versions into chunks of at most
+// MaxRecipientsPerRequest and issuing one SendTransactionalEmail call per chunk when the cap is exceeded.  It
+// returns a combined BatchSendResult covering every chunk; a failure in one chunk does not stop the remaining
+// chunks from being sent.
+func (c *Client) SendTransactionalEmailBatch(ctx context.Context, m *Message) (*BatchSendResult, error) {
+	if len(m.Versions) <= MaxRecipientsPerRequest {
+		resp, err := c.SendTransactionalEmail(ctx, m)
+		if err != nil {
+			return &BatchSendResult{Errors: []error{err}}, err
+		}
+		return &BatchSendResult{MessageIDs: messageIDsFrom(resp)}, nil
+	}
+
+	result := &BatchSendResult{}
+	for start := 0; start < len(m.Versions); start += MaxRecipientsPerRequest {
+		end := start + MaxRecipientsPerRequest
+		if end > len(m.Versions) {
+			end = len(m.Versions)
+		}
+
+		batch := *m
+		batch.Versions = m.Versions[start:end]
+		if start > 0 {
+			// m.To/Cc/Bcc are direct recipients of m itself; only the first chunk should carry them, or
+			// every later chunk would resend to them as well.
+			batch.To, batch.Cc, batch.Bcc = nil, nil, nil
+		}
+
+		resp, err := c.SendTransactionalEmail(ctx, &batch)
+		if err != nil {
+			result.Errors = append(result.Errors, err)
+			continue
+		}
+		result.MessageIDs = append(result.MessageIDs, messageIDsFrom(resp)...)
+	}
+	if len(result.MessageIDs) == 0 && len(result.Errors) > 0 {
+		return result, result.Errors[0]
+	}
+	return result, nil
+}
+
+func messageIDsFrom(resp *SendEmailResponse) []string {
+	if resp == nil {
+		return nil
+	}
+	if len(resp.MessageIDs) > 0 {
+		return resp.MessageIDs
+	}
+	if resp.MessageID != "" {
+		return []string{resp.MessageID}
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package sendinblue
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// DefaultBaseURL is the root of the SendInBlue v3 API against which a Client operates unless overridden.
+const DefaultBaseURL = "https://api.sendinblue.com/v3"
+
+// Client is a SendInBlue v3 API client.  It holds the credentials and HTTP configuration shared by all requests and should be reused across calls.
+type Client struct {
+
+	// APIKey is the SendInBlue API key used to authenticate all requests made by this Client
+	APIKey string
+
+	// BaseURL is the root of the SendInBlue API.  If empty, DefaultBaseURL is used.
+	BaseURL string
+
+	// HTTPClient is the http.Client used to make requests.  If nil, a client is built from Transport (if set), or
+	// http.DefaultClient otherwise.
+	HTTPClient *http.Client
+
+	// Transport, if set and HTTPClient is nil, is used as the http.RoundTripper for requests, allowing callers to
+	// plug in tracing or metrics (such as OpenTelemetry or Prometheus) without replacing the whole HTTPClient.
+	Transport http.RoundTripper
+
+	// RetryPolicy controls retry behavior for transient errors and 429/503 responses.  If nil, DefaultRetryPolicy is used.
+	RetryPolicy *RetryPolicy
+}
+
+// ClientOption configures optional parameters of a Client constructed with NewClient.
+type ClientOption func(*Client)
+
+// WithBaseURL overrides the root URL against which the Client makes requests.  This is primarily useful for testing against a mock server.
+func WithBaseURL(u string) ClientOption {
+	return func(c *Client) {
+		c.BaseURL = u
+	}
+}
+
+// WithHTTPClient overrides the http.Client used to make requests.
+func WithHTTPClient(hc *http.Client) ClientOption {
+	return func(c *Client) {
+		c.HTTPClient = hc
+	}
+}
+
+// WithTransport sets the http.RoundTripper used to make requests, without requiring the caller to build a whole
+// http.Client.  It has no effect if WithHTTPClient is also supplied.
+func WithTransport(rt http.RoundTripper) ClientOption {
+	return func(c *Client) {
+		c.Transport = rt
+	}
+}
+
+// WithRetryPolicy overrides DefaultRetryPolicy for this Client.
+func WithRetryPolicy(p *RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.RetryPolicy = p
+	}
+}
+
+// NewClient constructs a new Client for the given API key.
+func NewClient(apiKey string, opts ...ClientOption) *Client {
+	c := &Client{
+		APIKey: apiKey,
+	}
+	for _, o := range opts {
+		o(c)
+	}
+	return c
+}
+
+// APIError describes the JSON error body SendInBlue returns on non-2xx responses.
+type APIError struct {
+	// Code is the SendInBlue error code, such as "invalid_parameter" or "not_found"
+	Code string `json:"code"`
+
+	// Message is a human-readable description of the error
+	Message string `json:"message"`
+
+	// StatusCode is the HTTP status code of the response which produced this error
+	StatusCode int `json:"-"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("sendinblue: %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+func (c *Client) baseURL() string {
+	if c.BaseURL != "" {
+		return c.BaseURL
+	}
+	return DefaultBaseURL
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	if c.Transport != nil {
+		return &http.Client{Transport: c.Transport}
+	}
+	return http.DefaultClient
+}
+
+func (c *Client) retryPolicy() *RetryPolicy {
+	if c.RetryPolicy != nil {
+		return c.RetryPolicy
+	}
+	return DefaultRetryPolicy
+}
+
+// doRequest marshals body (if non-nil) as the JSON request payload, issues the request against path, and decodes
+// a successful JSON response into out (if non-nil).  Non-2xx responses are returned as an *APIError.  Network
+// errors and 429/500/502/503/504 responses are retried per the Client's RetryPolicy, honoring any Retry-After header
+// SendInBlue returns; all other non-2xx responses are treated as terminal.
+func (c *Client) doRequest(ctx context.Context, method, path string, body, out interface{}) error {
+	var bodyBytes []byte
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request body: %w", err)
+		}
+		bodyBytes = data
+	}
+
+	data, err := c.doRequestRaw(ctx, method, path, bodyBytes)
+	if err != nil {
+		return err
+	}
+
+	if out != nil && len(data) > 0 {
+		if err := json.Unmarshal(data, out); err != nil {
+			return fmt.Errorf("failed to decode response body: %w", err)
+		}
+	}
+	return nil
+}
+
+// doRequestRaw issues the request against path with the given raw body (which may be nil) and returns the raw
+// response body of a successful response, without any JSON decoding.  It shares doRequest's retry/backoff,
+// rate-limit, and error-unwrapping behavior, and is used by endpoints such as GetInboundAttachment whose response
+// is not JSON.
+func (c *Client) doRequestRaw(ctx context.Context, method, path string, bodyBytes []byte) ([]byte, error) {
+	var idempotencyKey string
+	if method == http.MethodPost {
+		idempotencyKey = newIdempotencyKey()
+	}
+
+	policy := c.retryPolicy()
+
+	var lastErr error
+	var nextDelay time.Duration
+	for attempt := 1; attempt <= policy.maxAttempts(); attempt++ {
+		if attempt > 1 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(nextDelay):
+			}
+		}
+		nextDelay = policy.backoff(attempt)
+
+		var reqBody io.Reader
+		if bodyBytes != nil {
+			reqBody = bytes.NewReader(bodyBytes)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, c.baseURL()+path, reqBody)
+		if err != nil {
+			return nil, fmt.Errorf("failed to construct request: %w", err)
+		}
+		req.Header.Set("api-key", c.APIKey)
+		req.Header.Set("Accept", "application/json")
+		if bodyBytes != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		if idempotencyKey != "" {
+			req.Header.Set("Idempotency-Key", idempotencyKey)
+		}
+
+		resp, err := c.httpClient().Do(req)
+		if err != nil {
+			lastErr = fmt.Errorf("failed to perform request: %w", err)
+			continue
+		}
+
+		data, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			lastErr = fmt.Errorf("failed to read response body: %w", err)
+			continue
+		}
+
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			apiErr := &APIError{StatusCode: resp.StatusCode}
+			if len(data) > 0 {
+				_ = json.Unmarshal(data, apiErr)
+			}
+			if apiErr.Message == "" {
+				apiErr.Message = resp.Status
+			}
+			lastErr = apiErr
+
+			if isRetryableStatus(resp.StatusCode) && attempt < policy.maxAttempts() {
+				if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+					nextDelay = wait
+				}
+				continue
+			}
+			return nil, apiErr
+		}
+
+		return data, nil
+	}
+	return nil, lastErr
+}
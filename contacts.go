@@ -0,0 +1,134 @@
+package sendinblue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Contact describes a SendInBlue contact to be created or updated via /contacts
+type Contact struct {
+
+	// Email is the contact's email address.  REQUIRED.
+	Email string `json:"email"`
+
+	// Attributes holds the contact attributes configured on the SendInBlue account, such as FIRSTNAME or LASTNAME
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+
+	// ListIDs are the identifiers of the lists this contact should be added to
+	ListIDs []int64 `json:"listIds,omitempty"`
+
+	// UpdateEnabled, when true, allows CreateContact to update an existing contact rather than failing
+	UpdateEnabled bool `json:"updateEnabled,omitempty"`
+}
+
+// CreateContactResponse is returned by Client.CreateContact on success
+type CreateContactResponse struct {
+
+	// ID is the identifier SendInBlue assigned to the newly-created contact
+	ID int64 `json:"id"`
+}
+
+// ContactDetails is returned by Client.GetContact
+type ContactDetails struct {
+
+	// ID is the contact's identifier
+	ID int64 `json:"id"`
+
+	// Email is the contact's email address
+	Email string `json:"email"`
+
+	// Attributes holds the contact's attribute values
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+
+	// ListIDs are the identifiers of the lists this contact belongs to
+	ListIDs []int64 `json:"listIds,omitempty"`
+
+	// EmailBlacklisted indicates whether the contact has been blacklisted from email sends
+	EmailBlacklisted bool `json:"emailBlacklisted"`
+
+	// SmsBlacklisted indicates whether the contact has been blacklisted from SMS sends
+	SmsBlacklisted bool `json:"smsBlacklisted"`
+}
+
+// CreateContact creates a new contact via POST /contacts
+func (c *Client) CreateContact(ctx context.Context, contact *Contact) (*CreateContactResponse, error) {
+	var out CreateContactResponse
+	if err := c.doRequest(ctx, http.MethodPost, "/contacts", contact, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetContact retrieves a contact by email or identifier via GET /contacts/{identifier}
+func (c *Client) GetContact(ctx context.Context, identifier string) (*ContactDetails, error) {
+	var out ContactDetails
+	path := fmt.Sprintf("/contacts/%s", url.PathEscape(identifier))
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateContact updates an existing contact via PUT /contacts/{identifier}
+func (c *Client) UpdateContact(ctx context.Context, identifier string, contact *Contact) error {
+	path := fmt.Sprintf("/contacts/%s", url.PathEscape(identifier))
+	return c.doRequest(ctx, http.MethodPut, path, contact, nil)
+}
+
+// DeleteContact removes a contact via DELETE /contacts/{identifier}
+func (c *Client) DeleteContact(ctx context.Context, identifier string) error {
+	path := fmt.Sprintf("/contacts/%s", url.PathEscape(identifier))
+	return c.doRequest(ctx, http.MethodDelete, path, nil, nil)
+}
+
+// List describes a SendInBlue contact list, managed via /contacts/lists
+type List struct {
+
+	// ID is the list's identifier.  It is assigned by SendInBlue and should not be set when creating a list.
+	ID int64 `json:"id,omitempty"`
+
+	// Name is the name of the list
+	Name string `json:"name"`
+
+	// FolderID is the identifier of the folder this list should be created in.  REQUIRED when creating a list.
+	FolderID int64 `json:"folderId,omitempty"`
+}
+
+// CreateListResponse is returned by Client.CreateList on success
+type CreateListResponse struct {
+
+	// ID is the identifier SendInBlue assigned to the newly-created list
+	ID int64 `json:"id"`
+}
+
+// CreateList creates a new contact list via POST /contacts/lists
+func (c *Client) CreateList(ctx context.Context, list *List) (*CreateListResponse, error) {
+	var out CreateListResponse
+	if err := c.doRequest(ctx, http.MethodPost, "/contacts/lists", list, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AddContactsToListResult is returned by Client.AddContactsToList
+type AddContactsToListResult struct {
+
+	// ContactsAdded is the number of contacts successfully added to the list
+	ContactsAdded int `json:"contacts,omitempty"`
+}
+
+// AddContactsToList adds the given contact emails to an existing list via POST /contacts/lists/{listID}/contacts/add
+func (c *Client) AddContactsToList(ctx context.Context, listID int64, emails []string) (*AddContactsToListResult, error) {
+	body := struct {
+		Emails []string `json:"emails"`
+	}{Emails: emails}
+
+	var out AddContactsToListResult
+	path := fmt.Sprintf("/contacts/lists/%d/contacts/add", listID)
+	if err := c.doRequest(ctx, http.MethodPost, path, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
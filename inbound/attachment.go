@@ -0,0 +1,13 @@
+package inbound
+
+import (
+	"context"
+
+	"github.com/CyCoreSystems/sendinblue"
+)
+
+// FetchAttachment retrieves the raw body of an inbound attachment using its DownloadToken, via c's SendInBlue
+// credentials.
+func FetchAttachment(ctx context.Context, c *sendinblue.Client, token string) ([]byte, error) {
+	return c.GetInboundAttachment(ctx, token)
+}
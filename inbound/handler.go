@@ -0,0 +1,64 @@
+package inbound
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+)
+
+// HandlerFunc processes a single IncomingMessage received from an Inbound Parsing webhook call.
+type HandlerFunc func(context.Context, *IncomingMessage) error
+
+// handlerConfig holds the options accumulated by HandlerOption functions
+type handlerConfig struct {
+	username string
+	password string
+}
+
+// HandlerOption configures optional parameters of a Handler.
+type HandlerOption func(*handlerConfig)
+
+// WithBasicAuth requires incoming webhook calls to present HTTP Basic credentials matching username and password,
+// as can be configured on the SendInBlue Inbound Parsing webhook itself.
+func WithBasicAuth(username, password string) HandlerOption {
+	return func(c *handlerConfig) {
+		c.username = username
+		c.password = password
+	}
+}
+
+// Handler returns an http.Handler which decodes each incoming request as an IncomingMessage via ParseIncoming and
+// passes it to fn.  If WithBasicAuth is supplied, requests without matching credentials are rejected with 401
+// before fn is invoked.
+func Handler(fn HandlerFunc, opts ...HandlerOption) http.Handler {
+	cfg := &handlerConfig{}
+	for _, o := range opts {
+		o(cfg)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if cfg.username != "" || cfg.password != "" {
+			user, pass, ok := r.BasicAuth()
+			userMatch := subtle.ConstantTimeCompare([]byte(user), []byte(cfg.username)) == 1
+			passMatch := subtle.ConstantTimeCompare([]byte(pass), []byte(cfg.password)) == 1
+			if !ok || !userMatch || !passMatch {
+				w.Header().Set("WWW-Authenticate", `Basic realm="sendinblue-inbound"`)
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+		}
+
+		msg, err := ParseIncoming(r.Body)
+		if err != nil {
+			http.Error(w, "failed to parse inbound message: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if err := fn(r.Context(), msg); err != nil {
+			http.Error(w, "failed to process inbound message: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -0,0 +1,108 @@
+// Package inbound decodes the webhook payloads posted by SendInBlue's Inbound Parsing API, giving applications
+// which already send mail through the parent sendinblue package a symmetric way to receive it.
+package inbound
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Address describes a single address (with optional display name) in an IncomingMessage
+type Address struct {
+
+	// Name is the display name associated with the address, if any
+	Name string `json:"Name,omitempty"`
+
+	// Address is the email address itself
+	Address string `json:"Address"`
+}
+
+// Attachment describes a single attachment on an inbound Item.  Its body is not included in the webhook payload
+// and must be retrieved separately via FetchAttachment using DownloadToken.
+type Attachment struct {
+
+	// Name is the filename of the attachment
+	Name string `json:"Name"`
+
+	// ContentType is the MIME type of the attachment
+	ContentType string `json:"ContentType"`
+
+	// ContentLength is the size of the attachment body, in bytes
+	ContentLength int64 `json:"ContentLength"`
+
+	// ContentID is the MIME Content-ID of the attachment, set when it is referenced inline from the message body
+	ContentID string `json:"ContentId,omitempty"`
+
+	// DownloadToken is passed to FetchAttachment to retrieve the attachment body
+	DownloadToken string `json:"DownloadToken"`
+}
+
+// Item describes a single received email within an IncomingMessage
+type Item struct {
+
+	// UUID is SendInBlue's unique identifier for this inbound item
+	UUID string `json:"Uuid"`
+
+	// MessageID is the Message-ID header of the received email
+	MessageID string `json:"MessageId"`
+
+	// InReplyTo is the Message-ID this email is a reply to, if any
+	InReplyTo string `json:"InReplyTo,omitempty"`
+
+	// From is the sender of the email
+	From *Address `json:"From"`
+
+	// To is the list of primary recipients of the email
+	To []*Address `json:"To"`
+
+	// Cc is the list of secondary recipients of the email
+	Cc []*Address `json:"Cc,omitempty"`
+
+	// ReplyTo is the address to which replies should be sent, if set by the sender
+	ReplyTo *Address `json:"ReplyTo,omitempty"`
+
+	// SenderIP is the IP address of the server which sent the email
+	SenderIP string `json:"SenderIp,omitempty"`
+
+	// Subject is the subject line of the email
+	Subject string `json:"Subject"`
+
+	// SpamScore is the spam score SendInBlue assigned to the email
+	SpamScore float64 `json:"SpamScore,omitempty"`
+
+	// SpfStatus is the result of the SPF check performed on the email, such as "pass" or "fail"
+	SpfStatus string `json:"SpfStatus,omitempty"`
+
+	// DkimStatus is the result of the DKIM check performed on the email, such as "pass" or "fail"
+	DkimStatus string `json:"DkimStatus,omitempty"`
+
+	// Headers holds the raw email headers, keyed by header name
+	Headers map[string]string `json:"Headers,omitempty"`
+
+	// RawTextBody is the plain-text body of the email
+	RawTextBody string `json:"RawTextBody,omitempty"`
+
+	// RawHTMLBody is the HTML body of the email
+	RawHTMLBody string `json:"RawHtmlBody,omitempty"`
+
+	// Attachments describes the attachments included with the email, if any
+	Attachments []*Attachment `json:"Attachments,omitempty"`
+}
+
+// IncomingMessage is the top-level payload SendInBlue POSTs to an Inbound Parsing webhook.  It may carry more
+// than one Item when SendInBlue batches multiple received emails into a single callback.
+type IncomingMessage struct {
+
+	// Items is the batch of received emails carried by this webhook call
+	Items []*Item `json:"items"`
+}
+
+// ParseIncoming decodes the JSON payload SendInBlue POSTs to an Inbound Parsing webhook.
+func ParseIncoming(r io.Reader) (*IncomingMessage, error) {
+	var msg IncomingMessage
+	if err := json.NewDecoder(r).Decode(&msg); err != nil {
+		return nil, fmt.Errorf("failed to decode inbound message: %w", err)
+	}
+	return &msg, nil
+}
@@ -0,0 +1,15 @@
+package sendinblue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// GetInboundAttachment downloads the raw body of an inbound attachment previously surfaced via the Inbound
+// Parsing webhook, identified by its DownloadToken, via GET /inbound/attachments/{token}.  Like every other
+// Client endpoint, it retries transient errors and 429/503 responses per the Client's RetryPolicy.
+func (c *Client) GetInboundAttachment(ctx context.Context, token string) ([]byte, error) {
+	return c.doRequestRaw(ctx, http.MethodGet, fmt.Sprintf("/inbound/attachments/%s", url.PathEscape(token)), nil)
+}
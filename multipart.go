@@ -0,0 +1,100 @@
+package sendinblue
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+)
+
+// base64LineLength is the maximum line length used when re-wrapping an already Base64-encoded attachment body for
+// MIME transport, per RFC 2045 section 6.8.
+const base64LineLength = 76
+
+// WriteMultipart serializes the Message, including its Attachments, to w as a multipart/mixed MIME body using
+// mime/multipart, so that callers can stream it directly to an SMTP relay or object store without first building
+// the whole message in memory. It returns the multipart boundary used, which callers must place in the
+// surrounding message's Content-Type header (e.g. `multipart/mixed; boundary="<boundary>"`).
+func (m *Message) WriteMultipart(w io.Writer) (string, error) {
+	mw := multipart.NewWriter(w)
+
+	if m.TextContent != "" {
+		if err := writeMultipartTextPart(mw, "text/plain; charset=UTF-8", m.TextContent); err != nil {
+			return mw.Boundary(), err
+		}
+	}
+	if m.HTMLContent != "" {
+		if err := writeMultipartTextPart(mw, "text/html; charset=UTF-8", m.HTMLContent); err != nil {
+			return mw.Boundary(), err
+		}
+	}
+
+	for _, a := range m.Attachments {
+		if err := writeMultipartAttachment(mw, a); err != nil {
+			return mw.Boundary(), err
+		}
+	}
+
+	boundary := mw.Boundary()
+	if err := mw.Close(); err != nil {
+		return boundary, fmt.Errorf("failed to close multipart writer: %w", err)
+	}
+	return boundary, nil
+}
+
+func writeMultipartTextPart(mw *multipart.Writer, contentType, body string) error {
+	pw, err := mw.CreatePart(textproto.MIMEHeader{"Content-Type": {contentType}})
+	if err != nil {
+		return fmt.Errorf("failed to create multipart body part: %w", err)
+	}
+	if _, err := io.WriteString(pw, body); err != nil {
+		return fmt.Errorf("failed to write multipart body part: %w", err)
+	}
+	return nil
+}
+
+func writeMultipartAttachment(mw *multipart.Writer, a *Attachment) error {
+	contentType := a.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	disposition := a.Disposition
+	if disposition == "" {
+		disposition = "attachment"
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", contentType)
+	header.Set("Content-Transfer-Encoding", "base64")
+	header.Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": a.Name}))
+	if a.ContentID != "" {
+		header.Set("Content-ID", "<"+a.ContentID+">")
+	}
+
+	pw, err := mw.CreatePart(header)
+	if err != nil {
+		return fmt.Errorf("failed to create multipart attachment part %q: %w", a.Name, err)
+	}
+	if _, err := io.WriteString(pw, wrapBase64Lines(a.Content)); err != nil {
+		return fmt.Errorf("failed to write multipart attachment part %q: %w", a.Name, err)
+	}
+	return nil
+}
+
+// wrapBase64Lines re-wraps an already Base64-encoded string at base64LineLength characters per line, as required
+// for a "base64" Content-Transfer-Encoding.
+func wrapBase64Lines(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i += base64LineLength {
+		end := i + base64LineLength
+		if end > len(s) {
+			end = len(s)
+		}
+		b.WriteString(s[i:end])
+		b.WriteString("\r\n")
+	}
+	return b.String()
+}
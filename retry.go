@@ -0,0 +1,115 @@
+package sendinblue
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	mrand "math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how a Client retries requests which fail with a transient error, such as a network error or
+// a 429/503 response from SendInBlue.
+type RetryPolicy struct {
+
+	// MaxAttempts is the maximum number of times a request will be attempted, including the first attempt.  A
+	// value of 1 disables retries.
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first retry.  Subsequent retries double this delay, up to MaxBackoff.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the delay between retries, regardless of attempt count.
+	MaxBackoff time.Duration
+
+	// Jitter, when true, randomizes each computed backoff by up to +/-50% to avoid thundering-herd retries across
+	// many clients.
+	Jitter bool
+}
+
+// DefaultRetryPolicy is used by a Client which does not set its own RetryPolicy.
+var DefaultRetryPolicy = &RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+	Jitter:         true,
+}
+
+func (p *RetryPolicy) maxAttempts() int {
+	if p == nil || p.MaxAttempts <= 0 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// backoff returns the delay to wait after attempt number attempt fails, before the next attempt (1-indexed: the
+// delay before the second overall attempt is backoff(1)).
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	if p == nil {
+		p = DefaultRetryPolicy
+	}
+
+	d := p.InitialBackoff
+	if d <= 0 {
+		d = DefaultRetryPolicy.InitialBackoff
+	}
+	max := p.MaxBackoff
+	if max <= 0 {
+		max = DefaultRetryPolicy.MaxBackoff
+	}
+
+	backoff := d << uint(attempt-1)
+	if backoff <= 0 || backoff > max {
+		backoff = max
+	}
+
+	if p.Jitter {
+		backoff = time.Duration(float64(backoff) * (0.5 + mrand.Float64()))
+		if backoff > max {
+			backoff = max
+		}
+	}
+	return backoff
+}
+
+// newIdempotencyKey generates a random key to be sent as the Idempotency-Key header on POST requests, so that
+// retried attempts of the same logical request are recognized by SendInBlue as duplicates rather than re-executed.
+func newIdempotencyKey() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// isRetryableStatus reports whether statusCode indicates a request which should be retried rather than
+// treated as a terminal failure: rate limiting (429), or a transient server error (500, 502, 503, 504).
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryAfter parses the Retry-After header, which SendInBlue may express either as an integer number of seconds
+// or as an HTTP-date, per RFC 7231 section 7.1.3.
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(header); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(header); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
@@ -2,10 +2,8 @@ package sendinblue
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
-	"encoding/json"
-	"errors"
-	"fmt"
 	"io"
 	"net/http"
 )
@@ -27,6 +25,15 @@ type Attachment struct {
 
 	// Content declares the inline content of the attachment, encoded as a Base64 string
 	Content string `json:"content"`
+
+	// ContentType is the MIME type of the attachment, as detected by AttachmentFromFile/AttachmentFromReader.  It is not part of the SendInBlue JSON API and is only consulted by Message.WriteMultipart.
+	ContentType string `json:"-"`
+
+	// ContentID, when set, allows this attachment to be referenced inline from HTMLContent via "cid:<ContentID>".  It is only honored by Message.WriteMultipart.
+	ContentID string `json:"-"`
+
+	// Disposition is the MIME Content-Disposition used by Message.WriteMultipart, either "attachment" or "inline".  Defaults to "attachment" if empty.
+	Disposition string `json:"-"`
 }
 
 // InlineAttachment returns a new Attachment from a byte-wise reader source.  The content will be converted to a Base64 string inside the Attachment.
@@ -84,29 +91,79 @@ type Message struct {
 
 	// Tags are arbitrary labels which are applied to this email in order to facilitate organizational operations in SendInBlue
 	Tags []string `json:"tags,omitempty"`
+
+	// Versions holds per-recipient overrides (recipients, subject, content, and template Params) for this message.  SendInBlue caps this at MaxRecipientsPerRequest entries per API call; use Client.SendTransactionalEmailBatch to send a Message whose Versions exceeds that cap.
+	Versions []*MessageVersion `json:"messageVersions,omitempty"`
 }
 
-// Send transmits the email message to SendInBlue
+// MessageVersion overrides per-recipient fields of a Message, allowing a single Message to carry distinct content
+// and template Params for each recipient via SendInBlue's messageVersions mechanism.
+type MessageVersion struct {
+
+	// To is the list of primary recipients who should receive this version of the message
+	To []*Address `json:"to"`
+
+	// Cc (carbon copy) overrides the secondary recipients for this version of the message
+	Cc []*Address `json:"cc,omitempty"`
+
+	// Bcc (blind carbon copy) overrides the undisclosed recipients for this version of the message
+	Bcc []*Address `json:"bcc,omitempty"`
+
+	// ReplyTo overrides the reply-to address for this version of the message
+	ReplyTo *Address `json:"replyTo,omitempty"`
+
+	// Subject overrides the subject of the message for this version
+	Subject string `json:"subject,omitempty"`
+
+	// Params overrides the template parameters used to populate the message for this version
+	Params map[string]string `json:"params,omitempty"`
+
+	// HTMLContent overrides the HTML-formatted content of the message for this version
+	HTMLContent string `json:"htmlContent,omitempty"`
+
+	// TextContent overrides the plain-text content of the message for this version
+	TextContent string `json:"textContent,omitempty"`
+}
+
+// AddRecipientVars appends a MessageVersion which sends to to with the given template vars, analogous to Mailgun's
+// recipientVariables.
+func (m *Message) AddRecipientVars(to *Address, vars map[string]string) {
+	m.Versions = append(m.Versions, &MessageVersion{
+		To:     []*Address{to},
+		Params: vars,
+	})
+}
+
+// DefaultClient is the Client used by Message.Send.  Advanced callers may customize it (for example, to install a
+// custom RetryPolicy or Transport) before calling Send.
+var DefaultClient = &Client{}
+
+// Send transmits the email message to SendInBlue.  It is a thin wrapper over DefaultClient.SendTransactionalEmail,
+// retained for backwards compatibility; new code should construct a Client directly to pass a context.Context or
+// customize retry/transport behavior.
 func (m *Message) Send(apiKey string) error {
-	url := "https://api.sendinblue.com/v3/smtp/email"
+	c := *DefaultClient
+	c.APIKey = apiKey
 
-	data, err := json.Marshal(m)
-	if err != nil {
-		return errors.New("failed to encode message: " + err.Error())
-	}
+	_, err := c.SendTransactionalEmail(context.Background(), m)
+	return err
+}
 
-	req, _ := http.NewRequest("POST", url, bytes.NewReader(data))
-	req.Header.Add("api-key", apiKey)
-	req.Header.Add("Content-Type", "application/json")
+// SendEmailResponse is returned by Client.SendTransactionalEmail on success
+type SendEmailResponse struct {
 
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return errors.New("failed to transmit message: " + err.Error())
-	}
-	defer resp.Body.Close()
+	// MessageID is the identifier SendInBlue assigned to the transmitted message
+	MessageID string `json:"messageId"`
+
+	// MessageIDs holds one identifier per recipient when the message was expanded into multiple recipient-specific sends
+	MessageIDs []string `json:"messageIds,omitempty"`
+}
 
-	if resp.StatusCode != 201 {
-		return fmt.Errorf("send failed: %d %s", resp.StatusCode, resp.Status)
+// SendTransactionalEmail transmits the email message to SendInBlue using the Client's credentials and honors ctx cancellation.
+func (c *Client) SendTransactionalEmail(ctx context.Context, m *Message) (*SendEmailResponse, error) {
+	var out SendEmailResponse
+	if err := c.doRequest(ctx, http.MethodPost, "/smtp/email", m, &out); err != nil {
+		return nil, err
 	}
-	return nil
+	return &out, nil
 }
@@ -0,0 +1,59 @@
+package sendinblue
+
+import (
+	"context"
+	"net/http"
+)
+
+// SMSRequest describes a transactional SMS to be sent via POST /transactionalSMS/sms
+type SMSRequest struct {
+
+	// Sender is the name or number the SMS should appear to come from
+	Sender string `json:"sender"`
+
+	// Recipient is the destination phone number, in E.164 format
+	Recipient string `json:"recipient"`
+
+	// Content is the text body of the SMS
+	Content string `json:"content"`
+
+	// Type indicates whether the SMS is "transactional" or "marketing".  Defaults to "transactional" if empty.
+	Type string `json:"type,omitempty"`
+
+	// Tag is an arbitrary label applied to the SMS for organizational purposes
+	Tag string `json:"tag,omitempty"`
+
+	// WebURL, if set, is notified of SMS status changes (delivered, bounced, and so on)
+	WebURL string `json:"webUrl,omitempty"`
+
+	// OrganisationPrefix overrides the default sender prefix used for some countries requiring originator registration
+	OrganisationPrefix string `json:"organisationPrefix,omitempty"`
+
+	// Unicode, when true, allows non-GSM characters in Content at the cost of a lower per-SMS character limit
+	Unicode bool `json:"unicode,omitempty"`
+}
+
+// SMSResponse is returned by Client.SendSMS on success
+type SMSResponse struct {
+
+	// MessageID is the identifier SendInBlue assigned to the transmitted SMS
+	MessageID string `json:"messageId"`
+
+	// SMSCount is the number of SMS segments the Content was split into
+	SMSCount int `json:"smsCount"`
+
+	// UsedCredits is the number of SMS credits consumed by this send
+	UsedCredits float64 `json:"usedCredits"`
+
+	// RemainingCredits is the number of SMS credits left on the account after this send
+	RemainingCredits float64 `json:"remainingCredits"`
+}
+
+// SendSMS transmits a transactional SMS via POST /transactionalSMS/sms
+func (c *Client) SendSMS(ctx context.Context, req *SMSRequest) (*SMSResponse, error) {
+	var out SMSResponse
+	if err := c.doRequest(ctx, http.MethodPost, "/transactionalSMS/sms", req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
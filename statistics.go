@@ -0,0 +1,135 @@
+package sendinblue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// EventStatisticsRequest describes the filters accepted by GET /smtp/statistics/events
+type EventStatisticsRequest struct {
+
+	// Limit caps the number of events returned.  If zero, the SendInBlue default (50) applies.
+	Limit int64
+
+	// Offset skips this many events from the start of the result set, for pagination
+	Offset int64
+
+	// StartDate restricts results to events on or after this date, formatted as YYYY-MM-DD
+	StartDate string
+
+	// EndDate restricts results to events on or before this date, formatted as YYYY-MM-DD
+	EndDate string
+
+	// Days restricts results to events within this many days of now.  Mutually exclusive with StartDate/EndDate.
+	Days int64
+
+	// Email restricts results to events for this recipient address
+	Email string
+
+	// Event restricts results to a single event type, such as "delivered", "opened", or "hardBounce"
+	Event string
+
+	// Tags restricts results to events carrying any of these tags
+	Tags []string
+
+	// MessageID restricts results to events for a single message
+	MessageID string
+
+	// TemplateID restricts results to events generated from a single template
+	TemplateID int64
+
+	// Sort controls the ordering of results, either "asc" or "desc".  Defaults to "desc".
+	Sort string
+}
+
+func (r *EventStatisticsRequest) queryString() string {
+	q := url.Values{}
+	if r == nil {
+		return q.Encode()
+	}
+	if r.Limit > 0 {
+		q.Set("limit", fmt.Sprintf("%d", r.Limit))
+	}
+	if r.Offset > 0 {
+		q.Set("offset", fmt.Sprintf("%d", r.Offset))
+	}
+	if r.StartDate != "" {
+		q.Set("startDate", r.StartDate)
+	}
+	if r.EndDate != "" {
+		q.Set("endDate", r.EndDate)
+	}
+	if r.Days > 0 {
+		q.Set("days", fmt.Sprintf("%d", r.Days))
+	}
+	if r.Email != "" {
+		q.Set("email", r.Email)
+	}
+	if r.Event != "" {
+		q.Set("event", r.Event)
+	}
+	for _, t := range r.Tags {
+		q.Add("tags", t)
+	}
+	if r.MessageID != "" {
+		q.Set("messageId", r.MessageID)
+	}
+	if r.TemplateID > 0 {
+		q.Set("templateId", fmt.Sprintf("%d", r.TemplateID))
+	}
+	if r.Sort != "" {
+		q.Set("sort", r.Sort)
+	}
+	return q.Encode()
+}
+
+// EmailEvent describes a single transactional email event, as returned by GET /smtp/statistics/events
+type EmailEvent struct {
+
+	// Email is the recipient address the event pertains to
+	Email string `json:"email"`
+
+	// Date is the time the event occurred, in SendInBlue's reporting timezone
+	Date string `json:"date"`
+
+	// Subject is the subject line of the message the event pertains to
+	Subject string `json:"subject"`
+
+	// MessageID is the identifier of the message the event pertains to
+	MessageID string `json:"messageId"`
+
+	// Event is the type of event, such as "delivered", "opened", or "hardBounce"
+	Event string `json:"event"`
+
+	// Reason, when present, describes why a bounce or block event occurred
+	Reason string `json:"reason,omitempty"`
+
+	// Tag is the arbitrary label applied to the originating message, if any
+	Tag string `json:"tag,omitempty"`
+
+	// TemplateID is the identifier of the template the originating message was sent from, if any
+	TemplateID int64 `json:"templateId,omitempty"`
+}
+
+// EventStatistics is returned by Client.GetEmailEvents
+type EventStatistics struct {
+
+	// Events is the page of events matching the request filters
+	Events []*EmailEvent `json:"events"`
+}
+
+// GetEmailEvents retrieves transactional email events via GET /smtp/statistics/events
+func (c *Client) GetEmailEvents(ctx context.Context, req *EventStatisticsRequest) (*EventStatistics, error) {
+	path := "/smtp/statistics/events"
+	if qs := req.queryString(); qs != "" {
+		path += "?" + qs
+	}
+
+	var out EventStatistics
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,94 @@
+package sendinblue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Template describes a SendInBlue transactional email template, managed via /smtp/templates
+type Template struct {
+
+	// ID is the template's identifier.  It is assigned by SendInBlue and should not be set when creating a template.
+	ID int64 `json:"id,omitempty"`
+
+	// TemplateName is the internal name of the template, shown in the SendInBlue dashboard
+	TemplateName string `json:"templateName"`
+
+	// Subject is the default subject line used by messages sent from this template
+	Subject string `json:"subject,omitempty"`
+
+	// Sender is the default sender used by messages sent from this template
+	Sender *Address `json:"sender,omitempty"`
+
+	// HTMLContent is the HTML body of the template
+	HTMLContent string `json:"htmlContent,omitempty"`
+
+	// ToField overrides the displayed "to" name on messages sent from this template
+	ToField string `json:"toField,omitempty"`
+
+	// Tag is an arbitrary label applied to the template for organizational purposes
+	Tag string `json:"tag,omitempty"`
+
+	// IsActive indicates whether the template may currently be used to send messages
+	IsActive bool `json:"isActive,omitempty"`
+}
+
+// CreateTemplateResponse is returned by Client.CreateTemplate on success
+type CreateTemplateResponse struct {
+
+	// ID is the identifier SendInBlue assigned to the newly-created template
+	ID int64 `json:"id"`
+}
+
+// TemplateList is returned by Client.ListTemplates
+type TemplateList struct {
+
+	// Count is the total number of templates on the account, independent of pagination
+	Count int `json:"count"`
+
+	// Templates is the page of templates returned by this call
+	Templates []*Template `json:"templates"`
+}
+
+// CreateTemplate creates a new transactional email template via POST /smtp/templates
+func (c *Client) CreateTemplate(ctx context.Context, t *Template) (*CreateTemplateResponse, error) {
+	var out CreateTemplateResponse
+	if err := c.doRequest(ctx, http.MethodPost, "/smtp/templates", t, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTemplate retrieves a single template by ID via GET /smtp/templates/{id}
+func (c *Client) GetTemplate(ctx context.Context, id int64) (*Template, error) {
+	var out Template
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/smtp/templates/%d", id), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateTemplate updates an existing template via PUT /smtp/templates/{id}
+func (c *Client) UpdateTemplate(ctx context.Context, id int64, t *Template) error {
+	return c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/smtp/templates/%d", id), t, nil)
+}
+
+// DeleteTemplate removes a template via DELETE /smtp/templates/{id}
+func (c *Client) DeleteTemplate(ctx context.Context, id int64) error {
+	return c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/smtp/templates/%d", id), nil, nil)
+}
+
+// ListTemplates retrieves a page of templates via GET /smtp/templates.  limit and offset control pagination; if limit is 0 it is omitted from the request and the SendInBlue default (50) applies.
+func (c *Client) ListTemplates(ctx context.Context, limit, offset int64) (*TemplateList, error) {
+	path := fmt.Sprintf("/smtp/templates?offset=%d", offset)
+	if limit > 0 {
+		path += fmt.Sprintf("&limit=%d", limit)
+	}
+
+	var out TemplateList
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}